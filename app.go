@@ -2,6 +2,7 @@ package app
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"os"
 
@@ -31,11 +32,22 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/supply"
+	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
+
+	"github.com/enigmampc/enigmachain/x/airdrop"
 )
 
-const appName = "enigma"
+const (
+	appName = "enigma"
+
+	// airdropUpgradeName is the name of the software upgrade plan that adds the
+	// airdrop module to a running chain. The plan height H must satisfy
+	// (H-1) % 100 == 0, so that the state at H-1 is flushed to disk by the
+	// previous binary with any pruning strategy.
+	airdropUpgradeName = "airdrop"
+)
 
 var (
 	// DefaultCLIHome default home directories for the application CLI
@@ -61,6 +73,7 @@ var (
 		supply.AppModuleBasic{},
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
+		airdrop.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -71,6 +84,7 @@ var (
 		staking.BondedPoolName:    {supply.Burner, supply.Staking},
 		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
 		gov.ModuleName:            {supply.Burner},
+		airdrop.ModuleName:        nil,
 	}
 )
 
@@ -115,6 +129,7 @@ type EnigmaChainApp struct {
 	paramsKeeper   params.Keeper
 	upgradeKeeper  upgrade.Keeper
 	evidenceKeeper evidence.Keeper
+	airdropKeeper  airdrop.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -153,6 +168,7 @@ func NewEnigmaChainApp(
 		params.StoreKey,
 		upgrade.StoreKey,
 		evidence.StoreKey,
+		airdrop.StoreKey,
 	)
 
 	tKeys := sdk.NewTransientStoreKeys(staking.TStoreKey, params.TStoreKey)
@@ -235,6 +251,13 @@ func NewEnigmaChainApp(
 		crisisSubspace, invCheckPeriod, app.supplyKeeper, auth.FeeCollectorName,
 	)
 	app.upgradeKeeper = upgrade.NewKeeper(skipUpgradeHeights, keys[upgrade.StoreKey], app.cdc)
+	app.airdropKeeper = airdrop.NewKeeper(app.cdc, keys[airdrop.StoreKey], app.supplyKeeper)
+
+	// NOTE: The airdrop store didn't exist before the airdrop upgrade. v0.38
+	// store upgrades can only rename or delete stores, so it is added by the
+	// app's store loader and initialized when the upgrade is applied.
+	app.upgradeKeeper.SetUpgradeHandler(airdropUpgradeName, app.applyAirdropUpgrade)
+	app.SetStoreLoader(app.loadStores)
 
 	// create evidence keeper with evidence router
 	evidenceKeeper := evidence.NewKeeper(
@@ -281,6 +304,7 @@ func NewEnigmaChainApp(
 		staking.NewAppModule(app.stakingKeeper, app.accountKeeper, app.supplyKeeper),
 		upgrade.NewAppModule(app.upgradeKeeper),
 		evidence.NewAppModule(app.evidenceKeeper),
+		airdrop.NewAppModule(app.airdropKeeper),
 	)
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
@@ -301,6 +325,7 @@ func NewEnigmaChainApp(
 		gov.ModuleName,
 		mint.ModuleName,
 		supply.ModuleName,
+		airdrop.ModuleName,
 		crisis.ModuleName,
 		genutil.ModuleName,
 		evidence.ModuleName,
@@ -353,6 +378,66 @@ func NewEnigmaChainApp(
 	return app
 }
 
+// applyAirdropUpgrade initializes the airdrop module state on a chain that
+// started without it, and leaves the state of a chain that already has the
+// module untouched.
+func (app *EnigmaChainApp) applyAirdropUpgrade(ctx sdk.Context, _ upgrade.Plan) {
+	if ctx.KVStore(app.keys[airdrop.StoreKey]).Has(airdrop.NextAirdropIDKey) {
+		return
+	}
+
+	// coins sent to the module address before the upgrade created a base
+	// account, which the supply keeper would refuse to use as a module account
+	macc := supply.NewEmptyModuleAccount(airdrop.ModuleName)
+	if acc := app.accountKeeper.GetAccount(ctx, macc.GetAddress()); acc != nil {
+		if _, ok := acc.(supplyexported.ModuleAccountI); !ok {
+			if err := macc.SetAccountNumber(acc.GetAccountNumber()); err != nil {
+				panic(err)
+			}
+			if err := macc.SetCoins(acc.GetCoins()); err != nil {
+				panic(err)
+			}
+			app.accountKeeper.SetAccount(ctx, macc)
+		}
+	}
+
+	app.airdropKeeper.SetNextAirdropID(ctx, airdrop.DefaultGenesisState().NextAirdropID)
+}
+
+// loadStores loads the latest version of the app's stores, and adds the
+// airdrop store to a chain that started without it.
+func (app *EnigmaChainApp) loadStores(ms sdk.CommitMultiStore) error {
+	if err := bam.DefaultStoreLoader(ms); err != nil {
+		return err
+	}
+
+	height := ms.LastCommitID().Version
+	ctx := sdk.NewContext(ms.CacheMultiStore(), abci.Header{Height: height}, false, app.Logger())
+	if height == 0 || ctx.KVStore(app.keys[airdrop.StoreKey]).Has(airdrop.NextAirdropIDKey) {
+		return nil
+	}
+
+	// blocks committed before the upgrade can't be replayed with the airdrop
+	// store mounted, as it would change their app hash
+	plan, found := app.upgradeKeeper.GetUpgradePlan(ctx)
+	if !found || plan.Name != airdropUpgradeName || plan.Height != height+1 {
+		return fmt.Errorf(
+			"state at height %d predates the %q upgrade: this binary must only be started from the state right before the upgrade height",
+			height, airdropUpgradeName,
+		)
+	}
+
+	// v0.38 stores always start at version 0, so the empty airdrop store is
+	// committed up to the loaded height to keep its versions in line with the
+	// other stores, which queries at a given height rely on
+	store := ms.GetCommitKVStore(app.keys[airdrop.StoreKey])
+	for store.LastCommitID().Version < height {
+		store.Commit()
+	}
+
+	return nil
+}
+
 // Name returns the name of the App
 func (app *EnigmaChainApp) Name() string { return app.BaseApp.Name() }
 
@@ -25,6 +25,7 @@ import (
 
 	app "github.com/enigmampc/enigmachain"
 	eng "github.com/enigmampc/enigmachain/types"
+	airdropcmd "github.com/enigmampc/enigmachain/x/airdrop/client/cli"
 )
 
 func main() {
@@ -57,6 +58,8 @@ func main() {
 		queryCmd(cdc),
 		txCmd(cdc),
 		flags.LineBreak,
+		airdropcmd.GetUtilsCmd(cdc),
+		flags.LineBreak,
 		lcd.ServeCommand(cdc, registerRoutes),
 		flags.LineBreak,
 		keys.Commands(),
@@ -0,0 +1,466 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	tmconfig "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto"
+	tmos "github.com/tendermint/tendermint/libs/os"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	"github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clientkeys "github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/server"
+	srvconfig "github.com/cosmos/cosmos-sdk/server/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+const (
+	flagNodeDirPrefix  = "node-dir-prefix"
+	flagNumValidators  = "v"
+	flagOutputDir      = "output-dir"
+	flagNodeDaemonHome = "node-daemon-home"
+	flagNodeCLIHome    = "node-cli-home"
+)
+
+const nodeDirPerm = 0755
+
+// testnetDenom is the native denom of the chain
+const testnetDenom = "uscrt"
+
+// Every node of the testnet runs on localhost with its own ports: node i
+// listens on the ports of node 0 offset by i * nodePortOffset.
+const (
+	p2pPort        = 26656
+	rpcPort        = 26657
+	prometheusPort = 26660
+	profPort       = 6060
+	nodePortOffset = 100
+)
+
+// TestnetCmd returns a command to initialize all files for a local multi-validator
+// tendermint testnet and application.
+func TestnetCmd(ctx *server.Context, cdc *codec.Codec,
+	mbm module.BasicManager, genAccIterator genutiltypes.GenesisAccountsIterator,
+) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:   "testnet",
+		Short: "Initialize files for an enigmad testnet",
+		Long: `testnet will create "v" number of directories and populate each with
+necessary files (private validator, genesis, config, systemd unit, etc.).
+
+All nodes run on localhost: node i listens for P2P, RPC, prometheus and
+profiler connections on ports 26656, 26657, 26660 and 6060 offset by i * 100,
+and its enigmacli home is configured to use its RPC port. The output directory
+must not exist or be empty.
+
+Note, strict routability for addresses is turned off in the config file.
+
+Example:
+	enigmad testnet --v 4 --output-dir ./output
+	`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			config := ctx.Config
+
+			// read from the flags, as viper prefers the values of the app.toml
+			// of the enigmad home to the flag defaults
+			minGasPrices, err := cmd.Flags().GetString(server.FlagMinGasPrices)
+			if err != nil {
+				return err
+			}
+			keyringBackend, err := cmd.Flags().GetString(flags.FlagKeyringBackend)
+			if err != nil {
+				return err
+			}
+
+			outputDir := viper.GetString(flagOutputDir)
+			chainID := viper.GetString(flags.FlagChainID)
+			nodeDirPrefix := viper.GetString(flagNodeDirPrefix)
+			nodeDaemonHome := viper.GetString(flagNodeDaemonHome)
+			nodeCLIHome := viper.GetString(flagNodeCLIHome)
+			numValidators := viper.GetInt(flagNumValidators)
+
+			return InitTestnet(cmd, config, cdc, mbm, genAccIterator, outputDir, chainID,
+				minGasPrices, nodeDirPrefix, nodeDaemonHome, nodeCLIHome, keyringBackend, numValidators)
+		},
+	}
+
+	cmd.Flags().Int(flagNumValidators, 4, "Number of validators to initialize the testnet with")
+	cmd.Flags().StringP(flagOutputDir, "o", "./mytestnet", "Directory to store initialization data for the testnet")
+	cmd.Flags().String(flagNodeDirPrefix, "node", "Prefix the directory name for each node with (node results in node0, node1, ...)")
+	cmd.Flags().String(flagNodeDaemonHome, "enigmad", "Home directory of the node's daemon configuration")
+	cmd.Flags().String(flagNodeCLIHome, "enigmacli", "Home directory of the node's cli configuration")
+	cmd.Flags().String(flags.FlagChainID, "", "genesis file chain-id, if left blank will be randomly created")
+	cmd.Flags().String(server.FlagMinGasPrices, fmt.Sprintf("0.000006%s", testnetDenom), "Minimum gas prices to accept for transactions; All fees in a tx must meet this minimum (e.g. 0.01uscrt)")
+	cmd.Flags().String(flags.FlagKeyringBackend, keys.BackendTest, "Select keyring's backend (os|file|test)")
+
+	return cmd
+}
+
+// InitTestnet initializes the node directories, keys, gentxs and genesis files
+// of a local testnet.
+func InitTestnet(cmd *cobra.Command, config *tmconfig.Config, cdc *codec.Codec,
+	mbm module.BasicManager, genAccIterator genutiltypes.GenesisAccountsIterator,
+	outputDir, chainID, minGasPrices, nodeDirPrefix, nodeDaemonHome,
+	nodeCLIHome, keyringBackend string, numValidators int) (err error) {
+
+	if numValidators < 1 {
+		return fmt.Errorf("the number of validators must be at least 1, got %d", numValidators)
+	}
+
+	outputDirExists, err := checkOutputDir(outputDir)
+	if err != nil {
+		return err
+	}
+
+	// on failure or panic, remove everything this run created and nothing else
+	created := []string{filepath.Join(outputDir, "gentxs")}
+	if !outputDirExists {
+		created = []string{outputDir}
+	}
+	defer func() {
+		r := recover()
+		if err != nil || r != nil {
+			for _, path := range created {
+				_ = os.RemoveAll(path)
+			}
+		}
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	if chainID == "" {
+		chainID = "chain-" + tmrand.Str(6)
+	}
+
+	monikers := make([]string, numValidators)
+	nodeIDs := make([]string, numValidators)
+	valPubKeys := make([]crypto.PubKey, numValidators)
+
+	enigmaConfig := srvconfig.DefaultConfig()
+	enigmaConfig.MinGasPrices = minGasPrices
+
+	//nolint:prealloc
+	var (
+		genAccounts []authexported.GenesisAccount
+		genFiles    []string
+	)
+
+	// the systemd units start nodes with the same binary that generated them
+	enigmad, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	// generate private keys, node IDs, and initial transactions
+	for i := 0; i < numValidators; i++ {
+		nodeDirName := fmt.Sprintf("%s%d", nodeDirPrefix, i)
+		nodeDir := filepath.Join(outputDir, nodeDirName, nodeDaemonHome)
+		clientDir := filepath.Join(outputDir, nodeDirName, nodeCLIHome)
+		gentxsDir := filepath.Join(outputDir, "gentxs")
+		if outputDirExists {
+			created = append(created, filepath.Join(outputDir, nodeDirName))
+		}
+
+		config.SetRoot(nodeDir)
+		setNodeAddresses(config, i)
+
+		if err := os.MkdirAll(filepath.Join(nodeDir, "config"), nodeDirPerm); err != nil {
+			return err
+		}
+
+		if err := os.MkdirAll(clientDir, nodeDirPerm); err != nil {
+			return err
+		}
+
+		monikers[i] = nodeDirName
+		config.Moniker = nodeDirName
+
+		nodeIDs[i], valPubKeys[i], err = genutil.InitializeNodeValidatorFiles(config)
+		if err != nil {
+			return err
+		}
+
+		memo := fmt.Sprintf("%s@127.0.0.1:%d", nodeIDs[i], nodePort(p2pPort, i))
+		genFiles = append(genFiles, config.GenesisFile())
+
+		kb, err := keys.NewKeyring(
+			sdk.KeyringServiceName(),
+			keyringBackend,
+			clientDir,
+			inBuf,
+		)
+		if err != nil {
+			return err
+		}
+
+		keyPass := clientkeys.DefaultKeyPass
+		addr, secret, err := server.GenerateSaveCoinKey(kb, nodeDirName, keyPass, true)
+		if err != nil {
+			return err
+		}
+
+		info := map[string]string{"secret": secret}
+
+		cliPrint, err := json.Marshal(info)
+		if err != nil {
+			return err
+		}
+
+		// save private key seed words
+		if err := writeFile(fmt.Sprintf("%v.json", "key_seed"), clientDir, cliPrint); err != nil {
+			return err
+		}
+
+		// point the node's enigmacli to its own RPC port and keyring
+		clientConfig := fmt.Sprintf("chain-id = %q\nkeyring-backend = %q\nnode = %q\n",
+			chainID, keyringBackend, config.RPC.ListenAddress)
+		if err := writeFile("config.toml", filepath.Join(clientDir, "config"), []byte(clientConfig)); err != nil {
+			return err
+		}
+
+		accTokens := sdk.TokensFromConsensusPower(1000)
+		coins := sdk.NewCoins(sdk.NewCoin(testnetDenom, accTokens))
+		genAccounts = append(genAccounts, auth.NewBaseAccount(addr, coins, nil, 0, 0))
+
+		valTokens := sdk.TokensFromConsensusPower(100)
+		msg := staking.NewMsgCreateValidator(
+			sdk.ValAddress(addr),
+			valPubKeys[i],
+			sdk.NewCoin(testnetDenom, valTokens),
+			staking.NewDescription(nodeDirName, "", "", "", ""),
+			staking.NewCommissionRates(sdk.OneDec(), sdk.OneDec(), sdk.OneDec()),
+			sdk.OneInt(),
+		)
+
+		tx := auth.NewStdTx([]sdk.Msg{msg}, auth.StdFee{}, []auth.StdSignature{}, memo)
+		txBldr := auth.NewTxBuilderFromCLI(inBuf).WithChainID(chainID).WithMemo(memo).WithKeybase(kb)
+
+		signedTx, err := txBldr.SignStdTx(nodeDirName, keyPass, tx, false)
+		if err != nil {
+			return err
+		}
+
+		txBytes, err := cdc.MarshalJSON(signedTx)
+		if err != nil {
+			return err
+		}
+
+		// gather gentxs folder
+		if err := writeFile(fmt.Sprintf("%v.json", nodeDirName), gentxsDir, txBytes); err != nil {
+			return err
+		}
+
+		enigmaConfigFilePath := filepath.Join(nodeDir, "config/app.toml")
+		srvconfig.WriteConfigFile(enigmaConfigFilePath, enigmaConfig)
+
+		// write a systemd unit so each node can run as a service, mirroring the
+		// enigma-node service installed by the debian package
+		absNodeDir, err := filepath.Abs(nodeDir)
+		if err != nil {
+			return err
+		}
+		unit := fmt.Sprintf(systemdUnitTemplate, nodeDirName, enigmad, absNodeDir)
+		if err := writeFile(fmt.Sprintf("enigma-%s.service", nodeDirName), filepath.Join(outputDir, nodeDirName), []byte(unit)); err != nil {
+			return err
+		}
+	}
+
+	if err := initGenFiles(cdc, mbm, chainID, genAccounts, genFiles, numValidators); err != nil {
+		return err
+	}
+
+	err = collectGenFiles(
+		cdc, config, chainID, monikers, nodeIDs, valPubKeys, numValidators,
+		outputDir, nodeDirPrefix, nodeDaemonHome, genAccIterator,
+	)
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintErrf("Successfully initialized %d node directories\n", numValidators)
+	return nil
+}
+
+// checkOutputDir makes sure the testnet doesn't overwrite existing files, and
+// returns whether the output directory already exists
+func checkOutputDir(outputDir string) (bool, error) {
+	files, err := ioutil.ReadDir(outputDir)
+	switch {
+	case os.IsNotExist(err):
+		return false, nil
+	case err != nil:
+		return false, err
+	case len(files) > 0:
+		return true, fmt.Errorf("output directory %s is not empty", outputDir)
+	}
+	return true, nil
+}
+
+// nodePort returns the port of the i-th node for a port of the first node
+func nodePort(port, i int) int {
+	return port + i*nodePortOffset
+}
+
+// setNodeAddresses sets the listen addresses of the i-th node
+func setNodeAddresses(config *tmconfig.Config, i int) {
+	config.P2P.ListenAddress = fmt.Sprintf("tcp://127.0.0.1:%d", nodePort(p2pPort, i))
+	config.P2P.AddrBookStrict = false
+	config.P2P.AllowDuplicateIP = true
+	config.RPC.ListenAddress = fmt.Sprintf("tcp://127.0.0.1:%d", nodePort(rpcPort, i))
+	config.Instrumentation.PrometheusListenAddr = fmt.Sprintf("127.0.0.1:%d", nodePort(prometheusPort, i))
+	config.ProfListenAddress = fmt.Sprintf("127.0.0.1:%d", nodePort(profPort, i))
+}
+
+const systemdUnitTemplate = `[Unit]
+Description=Enigma testnet %s service
+After=network.target
+
+[Service]
+Type=simple
+ExecStart=%s start --home %s
+Restart=always
+StartLimitInterval=0
+RestartSec=3
+LimitNOFILE=65535
+
+[Install]
+WantedBy=multi-user.target
+`
+
+func initGenFiles(cdc *codec.Codec, mbm module.BasicManager, chainID string,
+	genAccounts []authexported.GenesisAccount, genFiles []string, numValidators int) error {
+
+	appGenState := mbm.DefaultGenesis()
+
+	// set the accounts in the genesis state
+	authDataBz := appGenState[auth.ModuleName]
+	var authGenState auth.GenesisState
+	cdc.MustUnmarshalJSON(authDataBz, &authGenState)
+	authGenState.Accounts = genAccounts
+	appGenState[auth.ModuleName] = cdc.MustMarshalJSON(authGenState)
+
+	// use the native denom wherever the default genesis uses the default bond denom
+	var stakingGenState staking.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[staking.ModuleName], &stakingGenState)
+	stakingGenState.Params.BondDenom = testnetDenom
+	appGenState[staking.ModuleName] = cdc.MustMarshalJSON(stakingGenState)
+
+	var mintGenState mint.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[mint.ModuleName], &mintGenState)
+	mintGenState.Params.MintDenom = testnetDenom
+	appGenState[mint.ModuleName] = cdc.MustMarshalJSON(mintGenState)
+
+	var govGenState gov.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[gov.ModuleName], &govGenState)
+	for i := range govGenState.DepositParams.MinDeposit {
+		govGenState.DepositParams.MinDeposit[i].Denom = testnetDenom
+	}
+	appGenState[gov.ModuleName] = cdc.MustMarshalJSON(govGenState)
+
+	var crisisGenState crisis.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[crisis.ModuleName], &crisisGenState)
+	crisisGenState.ConstantFee.Denom = testnetDenom
+	appGenState[crisis.ModuleName] = cdc.MustMarshalJSON(crisisGenState)
+
+	appGenStateJSON, err := codec.MarshalJSONIndent(cdc, appGenState)
+	if err != nil {
+		return err
+	}
+
+	genDoc := types.GenesisDoc{
+		ChainID:    chainID,
+		AppState:   appGenStateJSON,
+		Validators: nil,
+	}
+
+	// generate empty genesis files for each validator and save
+	for i := 0; i < numValidators; i++ {
+		if err := genDoc.SaveAs(genFiles[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func collectGenFiles(
+	cdc *codec.Codec, config *tmconfig.Config, chainID string,
+	monikers, nodeIDs []string, valPubKeys []crypto.PubKey,
+	numValidators int, outputDir, nodeDirPrefix, nodeDaemonHome string,
+	genAccIterator genutiltypes.GenesisAccountsIterator) error {
+
+	var appState json.RawMessage
+	genTime := tmtime.Now()
+
+	for i := 0; i < numValidators; i++ {
+		nodeDirName := fmt.Sprintf("%s%d", nodeDirPrefix, i)
+		nodeDir := filepath.Join(outputDir, nodeDirName, nodeDaemonHome)
+		gentxsDir := filepath.Join(outputDir, "gentxs")
+		moniker := monikers[i]
+		config.Moniker = nodeDirName
+
+		config.SetRoot(nodeDir)
+		setNodeAddresses(config, i)
+
+		nodeID, valPubKey := nodeIDs[i], valPubKeys[i]
+		initCfg := genutil.NewInitConfig(chainID, gentxsDir, moniker, nodeID, valPubKey)
+
+		genDoc, err := types.GenesisDocFromFile(config.GenesisFile())
+		if err != nil {
+			return err
+		}
+
+		nodeAppState, err := genutil.GenAppStateFromConfig(cdc, config, initCfg, *genDoc, genAccIterator)
+		if err != nil {
+			return err
+		}
+
+		if appState == nil {
+			// set the canonical application state (they should not differ)
+			appState = nodeAppState
+		}
+
+		genFile := config.GenesisFile()
+
+		// overwrite each validator's genesis file to have a canonical genesis time
+		if err := genutil.ExportGenesisFileWithTime(genFile, chainID, nil, appState, genTime); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeFile(name string, dir string, contents []byte) error {
+	file := filepath.Join(dir, name)
+
+	err := tmos.EnsureDir(dir, 0700)
+	if err != nil {
+		return err
+	}
+
+	return tmos.WriteFile(file, contents, 0600)
+}
@@ -0,0 +1,65 @@
+package airdrop
+
+// nolint
+
+import (
+	"github.com/enigmampc/enigmachain/x/airdrop/internal/keeper"
+	"github.com/enigmampc/enigmachain/x/airdrop/internal/types"
+)
+
+const (
+	ModuleName       = types.ModuleName
+	StoreKey         = types.StoreKey
+	RouterKey        = types.RouterKey
+	QuerierRoute     = types.QuerierRoute
+	QueryAirdrop     = types.QueryAirdrop
+	QueryClaimStatus = types.QueryClaimStatus
+	MerkleHashSize   = types.MerkleHashSize
+)
+
+var (
+	// functions aliases
+	NewKeeper                 = keeper.NewKeeper
+	NewQuerier                = keeper.NewQuerier
+	RegisterInvariants        = keeper.RegisterInvariants
+	AllInvariants             = keeper.AllInvariants
+	ModuleAccountInvariant    = keeper.ModuleAccountInvariant
+	ClaimRecordsInvariant     = keeper.ClaimRecordsInvariant
+	RegisterCodec             = types.RegisterCodec
+	NewAirdrop                = types.NewAirdrop
+	NewClaimStatus            = types.NewClaimStatus
+	NewGenesisState           = types.NewGenesisState
+	DefaultGenesisState       = types.DefaultGenesisState
+	ValidateGenesis           = types.ValidateGenesis
+	NewMsgCreateAirdrop       = types.NewMsgCreateAirdrop
+	NewMsgClaimAirdrop        = types.NewMsgClaimAirdrop
+	NewMsgReclaimAirdrop      = types.NewMsgReclaimAirdrop
+	NewQueryAirdropParams     = types.NewQueryAirdropParams
+	NewQueryClaimStatusParams = types.NewQueryClaimStatusParams
+	LeafHash                  = types.LeafHash
+	BuildMerkleTree           = types.BuildMerkleTree
+	VerifyMerkleProof         = types.VerifyMerkleProof
+
+	// variable aliases
+	ModuleCdc             = types.ModuleCdc
+	NextAirdropIDKey      = types.NextAirdropIDKey
+	ErrUnknownAirdrop     = types.ErrUnknownAirdrop
+	ErrInvalidMerkleRoot  = types.ErrInvalidMerkleRoot
+	ErrInvalidProof       = types.ErrInvalidProof
+	ErrAlreadyClaimed     = types.ErrAlreadyClaimed
+	ErrInsufficientBudget = types.ErrInsufficientBudget
+	ErrInvalidEndHeight   = types.ErrInvalidEndHeight
+	ErrAirdropExpired     = types.ErrAirdropExpired
+	ErrAirdropNotExpired  = types.ErrAirdropNotExpired
+)
+
+type (
+	Keeper            = keeper.Keeper
+	Airdrop           = types.Airdrop
+	ClaimStatus       = types.ClaimStatus
+	ClaimRecord       = types.ClaimRecord
+	GenesisState      = types.GenesisState
+	MsgCreateAirdrop  = types.MsgCreateAirdrop
+	MsgClaimAirdrop   = types.MsgClaimAirdrop
+	MsgReclaimAirdrop = types.MsgReclaimAirdrop
+)
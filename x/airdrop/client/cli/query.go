@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/enigmampc/enigmachain/x/airdrop/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for the airdrop module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	airdropQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the airdrop module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	airdropQueryCmd.AddCommand(
+		flags.GetCommands(
+			GetCmdQueryAirdrop(cdc),
+			GetCmdQueryClaimStatus(cdc),
+		)...,
+	)
+
+	return airdropQueryCmd
+}
+
+// GetCmdQueryAirdrop implements a command to return an airdrop and its
+// remaining budget.
+func GetCmdQueryAirdrop(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "airdrop [airdrop-id]",
+		Short: "Query an airdrop and its remaining budget",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			airdropID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("airdrop-id %s not a valid uint, please input a valid airdrop-id", args[0])
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAirdropParams(airdropID))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAirdrop)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var airdrop types.Airdrop
+			if err := cdc.UnmarshalJSON(res, &airdrop); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(airdrop)
+		},
+	}
+}
+
+// GetCmdQueryClaimStatus implements a command to return whether an airdrop
+// allocation was already claimed.
+func GetCmdQueryClaimStatus(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim-status [airdrop-id] [index]",
+		Short: "Query whether the allocation at index of an airdrop was claimed",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			airdropID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("airdrop-id %s not a valid uint, please input a valid airdrop-id", args[0])
+			}
+
+			index, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("index %s not a valid uint, please input a valid index", args[1])
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryClaimStatusParams(airdropID, index))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryClaimStatus)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var status types.ClaimStatus
+			if err := cdc.UnmarshalJSON(res, &status); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(status)
+		},
+	}
+}
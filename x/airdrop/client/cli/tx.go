@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/enigmampc/enigmachain/x/airdrop/internal/types"
+)
+
+const flagProof = "proof"
+
+// GetTxCmd returns the transaction commands for the airdrop module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Airdrop transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(flags.PostCommands(
+		GetCmdCreateAirdrop(cdc),
+		GetCmdClaimAirdrop(cdc),
+		GetCmdReclaimAirdrop(cdc),
+	)...)
+	return txCmd
+}
+
+// GetCmdCreateAirdrop implements the create airdrop command
+func GetCmdCreateAirdrop(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create [merkle-root] [budget] [end-height]",
+		Short: "Escrow a budget that can be claimed by the recipients committed to in a merkle root",
+		Long: strings.TrimSpace(`Escrow a budget of coins that can be claimed by the recipients committed to
+in a hex encoded sha256 merkle root until the end height. Once the end height
+has passed, the remaining budget can be reclaimed by the creator. Every leaf of the tree must be computed as
+
+sha256(0x00 || uint64_big_endian(index) || recipient_address_bytes || amount)
+
+where amount is the canonical coins string of the allocation (e.g. 1000uscrt),
+and every inner node as sha256(0x01 || a || b), where a and b are the sorted
+children. The root and proofs of a distribution list can be computed with
+
+$ enigmacli airdrop build-tree distribution.csv
+
+Example:
+$ enigmacli tx airdrop create 4f1c...9a2e 1000000uscrt 500000 --from mykey
+`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			merkleRoot, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to decode merkle root: %w", err)
+			}
+
+			budget, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			endHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("end-height %s not a valid int, please input a valid end-height", args[2])
+			}
+
+			msg := types.NewMsgCreateAirdrop(cliCtx.GetFromAddress(), merkleRoot, budget, endHeight)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdClaimAirdrop implements the claim airdrop command
+func GetCmdClaimAirdrop(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim [airdrop-id] [index] [amount]",
+		Short: "Claim an airdrop allocation by proving its inclusion in the airdrop's merkle root",
+		Long: strings.TrimSpace(`Claim the allocation at index of an airdrop. The allocation is paid to the
+signer of the transaction, and must be proven with the comma separated, hex
+encoded sibling hashes from the leaf up to the merkle root.
+
+Example:
+$ enigmacli tx airdrop claim 1 42 1000uscrt --proof 9d3e...01ab,77c2...f310 --from mykey
+`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			airdropID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("airdrop-id %s not a valid uint, please input a valid airdrop-id", args[0])
+			}
+
+			index, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("index %s not a valid uint, please input a valid index", args[1])
+			}
+
+			amount, err := sdk.ParseCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			var proof []tmbytes.HexBytes
+			for _, node := range viper.GetStringSlice(flagProof) {
+				bz, err := hex.DecodeString(node)
+				if err != nil {
+					return fmt.Errorf("failed to decode proof node %s: %w", node, err)
+				}
+				proof = append(proof, bz)
+			}
+
+			msg := types.NewMsgClaimAirdrop(cliCtx.GetFromAddress(), airdropID, index, amount, proof)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringSlice(flagProof, []string{}, "Comma separated hex encoded merkle proof nodes")
+
+	return cmd
+}
+
+// GetCmdReclaimAirdrop implements the reclaim airdrop command
+func GetCmdReclaimAirdrop(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reclaim [airdrop-id]",
+		Short: "Reclaim the remaining budget of an expired airdrop",
+		Long: strings.TrimSpace(`Return the remaining budget of an airdrop whose end height has passed to its
+creator, and delete the airdrop along with its claim records. Only the creator
+of the airdrop can reclaim it.
+
+Example:
+$ enigmacli tx airdrop reclaim 1 --from mykey
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			airdropID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("airdrop-id %s not a valid uint, please input a valid airdrop-id", args[0])
+			}
+
+			msg := types.NewMsgReclaimAirdrop(cliCtx.GetFromAddress(), airdropID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/enigmampc/enigmachain/x/airdrop/internal/types"
+)
+
+// GetUtilsCmd returns the offline airdrop commands, which don't talk to a
+// node.
+func GetUtilsCmd(cdc *codec.Codec) *cobra.Command {
+	airdropUtilsCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Offline utilities for the airdrop module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	airdropUtilsCmd.AddCommand(GetCmdBuildTree(cdc))
+
+	return airdropUtilsCmd
+}
+
+// AllocationProof is an allocation of a distribution list along with the
+// merkle proof its recipient needs to claim it
+type AllocationProof struct {
+	Index     uint64   `json:"index" yaml:"index"`
+	Recipient string   `json:"recipient" yaml:"recipient"`
+	Amount    string   `json:"amount" yaml:"amount"`
+	Proof     []string `json:"proof" yaml:"proof"`
+}
+
+// MerkleTree is the merkle root of a distribution list along with the proofs
+// of all its allocations
+type MerkleTree struct {
+	MerkleRoot  string            `json:"merkle_root" yaml:"merkle_root"`
+	Allocations []AllocationProof `json:"allocations" yaml:"allocations"`
+}
+
+// GetCmdBuildTree implements a command to compute the merkle root and proofs
+// of an airdrop distribution list. It doesn't query the chain.
+func GetCmdBuildTree(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "build-tree [distribution-csv]",
+		Short: "Compute the merkle root and proofs of an airdrop distribution list",
+		Long: strings.TrimSpace(`Compute the merkle root and the proof of every allocation of an airdrop
+distribution list. The list is a CSV file with one "address,amount" row per
+allocation, and the index of an allocation is its row number starting at 0.
+
+Example:
+$ enigmacli airdrop build-tree distribution.csv
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			reader := csv.NewReader(file)
+			reader.FieldsPerRecord = 2
+			reader.TrimLeadingSpace = true
+
+			var (
+				leaves      [][]byte
+				allocations []AllocationProof
+			)
+			for index := uint64(0); ; index++ {
+				record, err := reader.Read()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					return err
+				}
+
+				recipient, err := sdk.AccAddressFromBech32(record[0])
+				if err != nil {
+					return fmt.Errorf("row %d: %w", index, err)
+				}
+
+				amount, err := sdk.ParseCoins(record[1])
+				if err != nil {
+					return fmt.Errorf("row %d: %w", index, err)
+				}
+
+				leaves = append(leaves, types.LeafHash(index, recipient, amount))
+				allocations = append(allocations, AllocationProof{
+					Index:     index,
+					Recipient: recipient.String(),
+					Amount:    amount.String(),
+				})
+			}
+
+			if len(leaves) == 0 {
+				return fmt.Errorf("distribution list %s is empty", args[0])
+			}
+
+			root, proofs := types.BuildMerkleTree(leaves)
+			for i, proof := range proofs {
+				allocations[i].Proof = []string{}
+				for _, node := range proof {
+					allocations[i].Proof = append(allocations[i].Proof, hex.EncodeToString(node))
+				}
+			}
+
+			return cliCtx.PrintOutput(MerkleTree{
+				MerkleRoot:  hex.EncodeToString(root),
+				Allocations: allocations,
+			})
+		},
+	}
+}
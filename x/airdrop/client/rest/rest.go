@@ -0,0 +1,19 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+// REST Variable names
+// nolint
+const (
+	RestAirdropID = "airdrop-id"
+	RestIndex     = "index"
+)
+
+// RegisterRoutes registers airdrop module REST handlers on the provided router.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerQueryRoutes(cliCtx, r)
+}
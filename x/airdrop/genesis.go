@@ -0,0 +1,37 @@
+package airdrop
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/enigmampc/enigmachain/x/airdrop/internal/keeper"
+	"github.com/enigmampc/enigmachain/x/airdrop/internal/types"
+)
+
+// InitGenesis initializes the airdrop module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	k.SetNextAirdropID(ctx, data.NextAirdropID)
+
+	var escrowed sdk.Coins
+	for _, airdrop := range data.Airdrops {
+		k.SetAirdrop(ctx, airdrop)
+		escrowed = escrowed.Add(airdrop.Remaining...)
+	}
+
+	for _, claim := range data.Claims {
+		k.SetClaimed(ctx, claim.AirdropID, claim.Index)
+	}
+
+	// check if the module account exists and holds the escrowed budgets
+	balance := k.GetModuleAccountBalance(ctx)
+	if !balance.IsAllGTE(escrowed) {
+		panic(fmt.Sprintf("airdrop module account balance %s is lower than the remaining airdrop budgets %s", balance, escrowed))
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return types.NewGenesisState(k.GetNextAirdropID(ctx), k.GetAirdrops(ctx), k.GetClaims(ctx))
+}
@@ -0,0 +1,104 @@
+package airdrop
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/enigmampc/enigmachain/x/airdrop/internal/keeper"
+	"github.com/enigmampc/enigmachain/x/airdrop/internal/types"
+)
+
+var initCoins = sdk.NewCoins(sdk.NewInt64Coin("uscrt", 1000000))
+
+func uscrt(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("uscrt", amount))
+}
+
+// distribution returns the merkle root and proofs of an airdrop paying
+// amounts[i] to keeper.TestAddrs[i+1]
+func distribution(amounts ...int64) (root []byte, proofs [][][]byte) {
+	leaves := make([][]byte, len(amounts))
+	for i, amount := range amounts {
+		leaves[i] = types.LeafHash(uint64(i), keeper.TestAddrs[i+1], uscrt(amount))
+	}
+	return types.BuildMerkleTree(leaves)
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	ctx, _, k := keeper.CreateTestInput(t, initCoins)
+	root, proofs := distribution(1000, 2000, 3000)
+
+	_, err := k.CreateAirdrop(ctx, keeper.TestAddrs[0], root, uscrt(6000), 100)
+	require.NoError(t, err)
+	airdropID, err := k.CreateAirdrop(ctx, keeper.TestAddrs[0], root, uscrt(6000), 200)
+	require.NoError(t, err)
+	require.NoError(t, k.Claim(ctx, airdropID, 1, keeper.TestAddrs[2], uscrt(2000), proofs[1]))
+	require.NoError(t, k.Claim(ctx, airdropID, 2, keeper.TestAddrs[3], uscrt(3000), proofs[2]))
+
+	exported := ExportGenesis(ctx, k)
+	require.NoError(t, ValidateGenesis(exported))
+	require.Equal(t, uint64(3), exported.NextAirdropID)
+	require.Len(t, exported.Airdrops, 2)
+	require.Len(t, exported.Claims, 2)
+
+	// import into a new chain whose module account holds the escrowed coins
+	newCtx, ak, newK := keeper.CreateTestInput(t, initCoins)
+	macc := ak.GetAccount(newCtx, supply.NewModuleAddress(ModuleName))
+	require.NoError(t, macc.SetCoins(k.GetModuleAccountBalance(ctx)))
+	ak.SetAccount(newCtx, macc)
+
+	InitGenesis(newCtx, newK, exported)
+	require.Equal(t, exported, ExportGenesis(newCtx, newK))
+
+	// the imported state behaves like the exported one
+	err = newK.Claim(newCtx, airdropID, 1, keeper.TestAddrs[2], uscrt(2000), proofs[1])
+	require.True(t, errors.Is(err, ErrAlreadyClaimed))
+	require.NoError(t, newK.Claim(newCtx, airdropID, 0, keeper.TestAddrs[1], uscrt(1000), proofs[0]))
+
+	msg, broken := AllInvariants(newK)(newCtx)
+	require.False(t, broken, msg)
+}
+
+func TestInitGenesisUnfundedModuleAccount(t *testing.T) {
+	ctx, _, k := keeper.CreateTestInput(t, initCoins)
+	root, _ := distribution(1000)
+
+	_, err := k.CreateAirdrop(ctx, keeper.TestAddrs[0], root, uscrt(1000), 100)
+	require.NoError(t, err)
+	exported := ExportGenesis(ctx, k)
+
+	newCtx, _, newK := keeper.CreateTestInput(t, initCoins)
+	require.Panics(t, func() { InitGenesis(newCtx, newK, exported) })
+}
+
+func TestValidateGenesis(t *testing.T) {
+	airdrop := NewAirdrop(1, keeper.TestAddrs[0], make([]byte, MerkleHashSize), uscrt(1000), 100)
+
+	testCases := []struct {
+		name    string
+		genesis GenesisState
+		valid   bool
+	}{
+		{"default", DefaultGenesisState(), true},
+		{"valid", NewGenesisState(2, []Airdrop{airdrop}, []ClaimRecord{{AirdropID: 1, Index: 0}}), true},
+		{"zero next id", NewGenesisState(0, nil, nil), false},
+		{"airdrop id out of range", NewGenesisState(1, []Airdrop{airdrop}, nil), false},
+		{"duplicate airdrop", NewGenesisState(2, []Airdrop{airdrop, airdrop}, nil), false},
+		{"claim of unknown airdrop", NewGenesisState(2, []Airdrop{airdrop}, []ClaimRecord{{AirdropID: 2, Index: 0}}), false},
+		{"no end height", NewGenesisState(2, []Airdrop{NewAirdrop(1, keeper.TestAddrs[0], make([]byte, MerkleHashSize), uscrt(1000), 0)}, nil), false},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateGenesis(tc.genesis)
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package airdrop
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/enigmampc/enigmachain/x/airdrop/internal/keeper"
+	"github.com/enigmampc/enigmachain/x/airdrop/internal/types"
+)
+
+// NewHandler returns a handler for "airdrop" type messages.
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgCreateAirdrop:
+			return handleMsgCreateAirdrop(ctx, msg, k)
+
+		case types.MsgClaimAirdrop:
+			return handleMsgClaimAirdrop(ctx, msg, k)
+
+		case types.MsgReclaimAirdrop:
+			return handleMsgReclaimAirdrop(ctx, msg, k)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized airdrop message type: %T", msg)
+		}
+	}
+}
+
+func handleMsgCreateAirdrop(ctx sdk.Context, msg types.MsgCreateAirdrop, k keeper.Keeper) (*sdk.Result, error) {
+	airdropID, err := k.CreateAirdrop(ctx, msg.Creator, msg.MerkleRoot, msg.Budget, msg.EndHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateAirdrop,
+			sdk.NewAttribute(types.AttributeKeyAirdropID, fmt.Sprintf("%d", airdropID)),
+			sdk.NewAttribute(types.AttributeKeyMerkleRoot, msg.MerkleRoot.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Budget.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Creator.String()),
+		),
+	})
+
+	return &sdk.Result{
+		Data:   types.GetAirdropIDBytes(airdropID),
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgClaimAirdrop(ctx sdk.Context, msg types.MsgClaimAirdrop, k keeper.Keeper) (*sdk.Result, error) {
+	err := k.Claim(ctx, msg.AirdropID, msg.Index, msg.Claimer, msg.Amount, msg.ProofBytes())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimAirdrop,
+			sdk.NewAttribute(types.AttributeKeyAirdropID, fmt.Sprintf("%d", msg.AirdropID)),
+			sdk.NewAttribute(types.AttributeKeyIndex, fmt.Sprintf("%d", msg.Index)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Claimer.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgReclaimAirdrop(ctx sdk.Context, msg types.MsgReclaimAirdrop, k keeper.Keeper) (*sdk.Result, error) {
+	reclaimed, err := k.Reclaim(ctx, msg.AirdropID, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeReclaimAirdrop,
+			sdk.NewAttribute(types.AttributeKeyAirdropID, fmt.Sprintf("%d", msg.AirdropID)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, reclaimed.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Creator.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
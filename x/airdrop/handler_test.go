@@ -0,0 +1,64 @@
+package airdrop
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/enigmampc/enigmachain/x/airdrop/internal/keeper"
+	"github.com/enigmampc/enigmachain/x/airdrop/internal/types"
+)
+
+func hexProof(proof [][]byte) []tmbytes.HexBytes {
+	hexProof := make([]tmbytes.HexBytes, len(proof))
+	for i, node := range proof {
+		hexProof[i] = node
+	}
+	return hexProof
+}
+
+func requireEvent(t *testing.T, events sdk.Events, eventType string) {
+	for _, event := range events {
+		if event.Type == eventType {
+			return
+		}
+	}
+	t.Fatalf("no %s event in %v", eventType, events)
+}
+
+func TestHandler(t *testing.T) {
+	ctx, ak, k := keeper.CreateTestInput(t, initCoins)
+	handler := NewHandler(k)
+	creator := keeper.TestAddrs[0]
+	root, proofs := distribution(1000, 2000)
+
+	res, err := handler(ctx, NewMsgCreateAirdrop(creator, root, uscrt(3000), 100))
+	require.NoError(t, err)
+	airdropID := types.GetAirdropIDFromBytes(res.Data)
+	require.Equal(t, uint64(1), airdropID)
+	requireEvent(t, res.Events, types.EventTypeCreateAirdrop)
+
+	claim := NewMsgClaimAirdrop(keeper.TestAddrs[1], airdropID, 0, uscrt(1000), hexProof(proofs[0]))
+	res, err = handler(ctx, claim)
+	require.NoError(t, err)
+	requireEvent(t, res.Events, types.EventTypeClaimAirdrop)
+
+	_, err = handler(ctx, claim)
+	require.True(t, errors.Is(err, ErrAlreadyClaimed))
+
+	_, err = handler(ctx, NewMsgReclaimAirdrop(creator, airdropID))
+	require.True(t, errors.Is(err, ErrAirdropNotExpired))
+
+	res, err = handler(ctx.WithBlockHeight(101), NewMsgReclaimAirdrop(creator, airdropID))
+	require.NoError(t, err)
+	requireEvent(t, res.Events, types.EventTypeReclaimAirdrop)
+	require.Equal(t, initCoins.Sub(uscrt(1000)), ak.GetAccount(ctx, creator).GetCoins())
+
+	_, err = handler(ctx, sdk.NewTestMsg(creator))
+	require.True(t, errors.Is(err, sdkerrors.ErrUnknownRequest))
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/enigmampc/enigmachain/x/airdrop/internal/types"
+)
+
+// RegisterInvariants registers all airdrop invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "claim-records", ClaimRecordsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the airdrop module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ModuleAccountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return ClaimRecordsInvariant(k)(ctx)
+	}
+}
+
+// ModuleAccountInvariant checks that the module account holds enough coins to
+// pay out the remaining budget of every airdrop. The balance may be higher, as
+// coins could be sent to the module address before the airdrop upgrade.
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var expectedBalance sdk.Coins
+
+		k.IterateAirdrops(ctx, func(airdrop types.Airdrop) bool {
+			expectedBalance = expectedBalance.Add(airdrop.Remaining...)
+			return false
+		})
+
+		balance := k.GetModuleAccountBalance(ctx)
+
+		broken := !balance.IsAllGTE(expectedBalance)
+		return sdk.FormatInvariant(types.ModuleName, "module-account",
+			fmt.Sprintf("\tairdrop ModuleAccount coins: %s\n\tsum of remaining airdrop budgets: %s\n",
+				balance, expectedBalance)), broken
+	}
+}
+
+// ClaimRecordsInvariant checks that every claim record belongs to an airdrop
+// that hasn't been reclaimed
+func ClaimRecordsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		k.IterateClaims(ctx, func(claim types.ClaimRecord) bool {
+			if _, found := k.GetAirdrop(ctx, claim.AirdropID); !found {
+				msg = fmt.Sprintf("\tclaim record %d of unknown airdrop %d\n", claim.Index, claim.AirdropID)
+				broken = true
+			}
+			return broken
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "claim-records", msg), broken
+	}
+}
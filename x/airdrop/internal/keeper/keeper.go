@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/enigmampc/enigmachain/x/airdrop/internal/types"
+)
+
+// Keeper of the airdrop store
+type Keeper struct {
+	cdc          *codec.Codec
+	storeKey     sdk.StoreKey
+	supplyKeeper types.SupplyKeeper
+}
+
+// NewKeeper creates a new airdrop Keeper instance
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, supplyKeeper types.SupplyKeeper) Keeper {
+	// ensure airdrop module account is set
+	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the airdrop module account has not been set")
+	}
+
+	return Keeper{
+		cdc:          cdc,
+		storeKey:     key,
+		supplyKeeper: supplyKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// CreateAirdrop escrows the budget from the creator's account and stores a new
+// airdrop claimable until endHeight, returning its ID
+func (k Keeper) CreateAirdrop(
+	ctx sdk.Context, creator sdk.AccAddress, merkleRoot []byte, budget sdk.Coins, endHeight int64,
+) (uint64, error) {
+
+	if endHeight <= ctx.BlockHeight() {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidEndHeight, "%d is not after the current height %d", endHeight, ctx.BlockHeight())
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, budget); err != nil {
+		return 0, err
+	}
+
+	airdropID := k.GetNextAirdropID(ctx)
+	k.SetAirdrop(ctx, types.NewAirdrop(airdropID, creator, merkleRoot, budget, endHeight))
+	k.SetNextAirdropID(ctx, airdropID+1)
+
+	k.Logger(ctx).Info(fmt.Sprintf("created airdrop %d with budget %s", airdropID, budget))
+	return airdropID, nil
+}
+
+// Claim verifies the merkle proof of an allocation and pays it out of the
+// airdrop's remaining budget
+func (k Keeper) Claim(
+	ctx sdk.Context, airdropID, index uint64, recipient sdk.AccAddress, amount sdk.Coins, proof [][]byte,
+) error {
+
+	airdrop, found := k.GetAirdrop(ctx, airdropID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownAirdrop, "%d", airdropID)
+	}
+
+	if airdrop.IsExpired(ctx.BlockHeight()) {
+		return sdkerrors.Wrapf(types.ErrAirdropExpired, "airdrop %d ended at height %d", airdropID, airdrop.EndHeight)
+	}
+
+	if k.IsClaimed(ctx, airdropID, index) {
+		return sdkerrors.Wrapf(types.ErrAlreadyClaimed, "airdrop %d, index %d", airdropID, index)
+	}
+
+	if !types.VerifyMerkleProof(airdrop.MerkleRoot, types.LeafHash(index, recipient, amount), proof) {
+		return sdkerrors.Wrapf(types.ErrInvalidProof, "airdrop %d, index %d", airdropID, index)
+	}
+
+	remaining, hasNeg := airdrop.Remaining.SafeSub(amount)
+	if hasNeg {
+		return sdkerrors.Wrapf(types.ErrInsufficientBudget, "%s < %s", airdrop.Remaining, amount)
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount); err != nil {
+		return err
+	}
+
+	airdrop.Remaining = remaining
+	k.SetAirdrop(ctx, airdrop)
+	k.SetClaimed(ctx, airdropID, index)
+
+	return nil
+}
+
+// Reclaim returns the remaining budget of an expired airdrop to its creator and
+// deletes the airdrop along with its claim records
+func (k Keeper) Reclaim(ctx sdk.Context, airdropID uint64, creator sdk.AccAddress) (sdk.Coins, error) {
+	airdrop, found := k.GetAirdrop(ctx, airdropID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownAirdrop, "%d", airdropID)
+	}
+
+	if !airdrop.Creator.Equals(creator) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the creator of airdrop %d", creator, airdropID)
+	}
+
+	if !airdrop.IsExpired(ctx.BlockHeight()) {
+		return nil, sdkerrors.Wrapf(types.ErrAirdropNotExpired, "airdrop %d ends at height %d", airdropID, airdrop.EndHeight)
+	}
+
+	if !airdrop.Remaining.IsZero() {
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creator, airdrop.Remaining)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	k.DeleteAirdrop(ctx, airdropID)
+	k.DeleteClaims(ctx, airdropID)
+
+	k.Logger(ctx).Info(fmt.Sprintf("reclaimed %s from airdrop %d", airdrop.Remaining, airdropID))
+	return airdrop.Remaining, nil
+}
+
+// GetNextAirdropID gets the ID that will be assigned to the next airdrop
+func (k Keeper) GetNextAirdropID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextAirdropIDKey)
+	if bz == nil {
+		panic("initial airdrop ID hasn't been set")
+	}
+
+	return types.GetAirdropIDFromBytes(bz)
+}
+
+// SetNextAirdropID sets the ID that will be assigned to the next airdrop
+func (k Keeper) SetNextAirdropID(ctx sdk.Context, airdropID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextAirdropIDKey, types.GetAirdropIDBytes(airdropID))
+}
+
+// GetAirdrop gets an airdrop from the store
+func (k Keeper) GetAirdrop(ctx sdk.Context, airdropID uint64) (airdrop types.Airdrop, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.AirdropKey(airdropID))
+	if bz == nil {
+		return airdrop, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &airdrop)
+	return airdrop, true
+}
+
+// SetAirdrop sets an airdrop to the store
+func (k Keeper) SetAirdrop(ctx sdk.Context, airdrop types.Airdrop) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(airdrop)
+	store.Set(types.AirdropKey(airdrop.ID), bz)
+}
+
+// DeleteAirdrop deletes an airdrop from the store
+func (k Keeper) DeleteAirdrop(ctx sdk.Context, airdropID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AirdropKey(airdropID))
+}
+
+// IterateAirdrops iterates over all the airdrops and performs a callback function
+func (k Keeper) IterateAirdrops(ctx sdk.Context, cb func(airdrop types.Airdrop) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.AirdropsKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var airdrop types.Airdrop
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &airdrop)
+
+		if cb(airdrop) {
+			break
+		}
+	}
+}
+
+// GetAirdrops returns all the airdrops from store
+func (k Keeper) GetAirdrops(ctx sdk.Context) (airdrops []types.Airdrop) {
+	k.IterateAirdrops(ctx, func(airdrop types.Airdrop) bool {
+		airdrops = append(airdrops, airdrop)
+		return false
+	})
+	return
+}
+
+// IsClaimed returns whether the allocation at index of an airdrop was claimed
+func (k Keeper) IsClaimed(ctx sdk.Context, airdropID, index uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ClaimKey(airdropID, index))
+}
+
+// SetClaimed marks the allocation at index of an airdrop as claimed
+func (k Keeper) SetClaimed(ctx sdk.Context, airdropID, index uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ClaimKey(airdropID, index), []byte{0x01})
+}
+
+// DeleteClaims deletes all the claim records of an airdrop
+func (k Keeper) DeleteClaims(ctx sdk.Context, airdropID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ClaimsKey(airdropID))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IterateClaims iterates over all the claim records and performs a callback function
+func (k Keeper) IterateClaims(ctx sdk.Context, cb func(claim types.ClaimRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ClaimsKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		airdropID, index := types.SplitClaimKey(iterator.Key())
+
+		if cb(types.ClaimRecord{AirdropID: airdropID, Index: index}) {
+			break
+		}
+	}
+}
+
+// GetClaims returns all the claim records from store
+func (k Keeper) GetClaims(ctx sdk.Context) (claims []types.ClaimRecord) {
+	k.IterateClaims(ctx, func(claim types.ClaimRecord) bool {
+		claims = append(claims, claim)
+		return false
+	})
+	return
+}
+
+// GetModuleAccountBalance returns the coins escrowed by the airdrop module account
+func (k Keeper) GetModuleAccountBalance(ctx sdk.Context) sdk.Coins {
+	return k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins()
+}
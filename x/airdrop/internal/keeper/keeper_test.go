@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/enigmampc/enigmachain/x/airdrop/internal/types"
+)
+
+var initCoins = sdk.NewCoins(sdk.NewInt64Coin("uscrt", 1000000))
+
+func uscrt(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("uscrt", amount))
+}
+
+// distribution returns the merkle root and proofs of an airdrop paying
+// amounts[i] to TestAddrs[i+1]
+func distribution(amounts ...int64) (root []byte, proofs [][][]byte) {
+	leaves := make([][]byte, len(amounts))
+	for i, amount := range amounts {
+		leaves[i] = types.LeafHash(uint64(i), TestAddrs[i+1], uscrt(amount))
+	}
+	return types.BuildMerkleTree(leaves)
+}
+
+func requireInvariants(t *testing.T, ctx sdk.Context, k Keeper) {
+	msg, broken := AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestCreateAirdrop(t *testing.T) {
+	ctx, ak, k := CreateTestInput(t, initCoins)
+	creator := TestAddrs[0]
+	root, _ := distribution(1000, 2000, 3000)
+
+	_, err := k.CreateAirdrop(ctx, creator, root, uscrt(6000), ctx.BlockHeight())
+	require.True(t, errors.Is(err, types.ErrInvalidEndHeight))
+
+	_, err = k.CreateAirdrop(ctx, creator, root, initCoins.Add(uscrt(1)...), 100)
+	require.True(t, errors.Is(err, sdkerrors.ErrInsufficientFunds))
+
+	airdropID, err := k.CreateAirdrop(ctx, creator, root, uscrt(6000), 100)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), airdropID)
+	require.Equal(t, uint64(2), k.GetNextAirdropID(ctx))
+
+	airdrop, found := k.GetAirdrop(ctx, airdropID)
+	require.True(t, found)
+	require.Equal(t, types.NewAirdrop(airdropID, creator, root, uscrt(6000), 100), airdrop)
+
+	require.Equal(t, initCoins.Sub(uscrt(6000)), ak.GetAccount(ctx, creator).GetCoins())
+	require.Equal(t, uscrt(6000), k.GetModuleAccountBalance(ctx))
+	requireInvariants(t, ctx, k)
+}
+
+func TestClaim(t *testing.T) {
+	ctx, ak, k := CreateTestInput(t, initCoins)
+	root, proofs := distribution(1000, 2000, 3000)
+
+	airdropID, err := k.CreateAirdrop(ctx, TestAddrs[0], root, uscrt(6000), 100)
+	require.NoError(t, err)
+
+	err = k.Claim(ctx, airdropID+1, 0, TestAddrs[1], uscrt(1000), proofs[0])
+	require.True(t, errors.Is(err, types.ErrUnknownAirdrop))
+
+	err = k.Claim(ctx, airdropID, 0, TestAddrs[1], uscrt(2000), proofs[0])
+	require.True(t, errors.Is(err, types.ErrInvalidProof))
+
+	err = k.Claim(ctx, airdropID, 0, TestAddrs[2], uscrt(1000), proofs[0])
+	require.True(t, errors.Is(err, types.ErrInvalidProof))
+
+	require.False(t, k.IsClaimed(ctx, airdropID, 0))
+	err = k.Claim(ctx, airdropID, 0, TestAddrs[1], uscrt(1000), proofs[0])
+	require.NoError(t, err)
+	require.True(t, k.IsClaimed(ctx, airdropID, 0))
+	require.Equal(t, initCoins.Add(uscrt(1000)...), ak.GetAccount(ctx, TestAddrs[1]).GetCoins())
+
+	err = k.Claim(ctx, airdropID, 0, TestAddrs[1], uscrt(1000), proofs[0])
+	require.True(t, errors.Is(err, types.ErrAlreadyClaimed))
+	require.Equal(t, initCoins.Add(uscrt(1000)...), ak.GetAccount(ctx, TestAddrs[1]).GetCoins())
+
+	// the module account balance and the remaining budget move together
+	for i, amount := range []int64{2000, 3000} {
+		index := uint64(i + 1)
+		before := k.GetModuleAccountBalance(ctx)
+
+		err = k.Claim(ctx, airdropID, index, TestAddrs[index+1], uscrt(amount), proofs[index])
+		require.NoError(t, err)
+
+		airdrop, _ := k.GetAirdrop(ctx, airdropID)
+		require.Equal(t, before.Sub(uscrt(amount)), k.GetModuleAccountBalance(ctx))
+		require.Equal(t, k.GetModuleAccountBalance(ctx), airdrop.Remaining)
+		requireInvariants(t, ctx, k)
+	}
+
+	airdrop, _ := k.GetAirdrop(ctx, airdropID)
+	require.True(t, airdrop.Remaining.IsZero())
+	require.Equal(t, []types.ClaimRecord{
+		{AirdropID: airdropID, Index: 0},
+		{AirdropID: airdropID, Index: 1},
+		{AirdropID: airdropID, Index: 2},
+	}, k.GetClaims(ctx))
+}
+
+func TestClaimInsufficientBudget(t *testing.T) {
+	ctx, _, k := CreateTestInput(t, initCoins)
+	root, proofs := distribution(1000, 2000)
+
+	airdropID, err := k.CreateAirdrop(ctx, TestAddrs[0], root, uscrt(2500), 100)
+	require.NoError(t, err)
+
+	err = k.Claim(ctx, airdropID, 1, TestAddrs[2], uscrt(2000), proofs[1])
+	require.NoError(t, err)
+
+	err = k.Claim(ctx, airdropID, 0, TestAddrs[1], uscrt(1000), proofs[0])
+	require.True(t, errors.Is(err, types.ErrInsufficientBudget))
+	require.False(t, k.IsClaimed(ctx, airdropID, 0))
+
+	airdrop, _ := k.GetAirdrop(ctx, airdropID)
+	require.Equal(t, uscrt(500), airdrop.Remaining)
+	require.Equal(t, uscrt(500), k.GetModuleAccountBalance(ctx))
+	requireInvariants(t, ctx, k)
+}
+
+func TestClaimExpired(t *testing.T) {
+	ctx, _, k := CreateTestInput(t, initCoins)
+	root, proofs := distribution(1000, 2000)
+
+	airdropID, err := k.CreateAirdrop(ctx, TestAddrs[0], root, uscrt(3000), 100)
+	require.NoError(t, err)
+
+	err = k.Claim(ctx.WithBlockHeight(100), airdropID, 0, TestAddrs[1], uscrt(1000), proofs[0])
+	require.NoError(t, err)
+
+	err = k.Claim(ctx.WithBlockHeight(101), airdropID, 1, TestAddrs[2], uscrt(2000), proofs[1])
+	require.True(t, errors.Is(err, types.ErrAirdropExpired))
+	require.False(t, k.IsClaimed(ctx, airdropID, 1))
+}
+
+func TestReclaim(t *testing.T) {
+	ctx, ak, k := CreateTestInput(t, initCoins)
+	creator := TestAddrs[0]
+	root, proofs := distribution(1000, 2000)
+
+	airdropID, err := k.CreateAirdrop(ctx, creator, root, uscrt(3000), 100)
+	require.NoError(t, err)
+	otherID, err := k.CreateAirdrop(ctx, creator, root, uscrt(3000), 200)
+	require.NoError(t, err)
+
+	require.NoError(t, k.Claim(ctx, airdropID, 0, TestAddrs[1], uscrt(1000), proofs[0]))
+	require.NoError(t, k.Claim(ctx, otherID, 0, TestAddrs[1], uscrt(1000), proofs[0]))
+
+	_, err = k.Reclaim(ctx.WithBlockHeight(100), airdropID, creator)
+	require.True(t, errors.Is(err, types.ErrAirdropNotExpired))
+
+	expiredCtx := ctx.WithBlockHeight(101)
+
+	_, err = k.Reclaim(expiredCtx, airdropID, TestAddrs[1])
+	require.True(t, errors.Is(err, sdkerrors.ErrUnauthorized))
+
+	_, err = k.Reclaim(expiredCtx, otherID+1, creator)
+	require.True(t, errors.Is(err, types.ErrUnknownAirdrop))
+
+	reclaimed, err := k.Reclaim(expiredCtx, airdropID, creator)
+	require.NoError(t, err)
+	require.Equal(t, uscrt(2000), reclaimed)
+	require.Equal(t, initCoins.Sub(uscrt(6000)).Add(uscrt(2000)...), ak.GetAccount(ctx, creator).GetCoins())
+
+	// only the other airdrop and its claim record are left
+	_, found := k.GetAirdrop(ctx, airdropID)
+	require.False(t, found)
+	require.False(t, k.IsClaimed(ctx, airdropID, 0))
+	require.True(t, k.IsClaimed(ctx, otherID, 0))
+	require.Equal(t, []types.ClaimRecord{{AirdropID: otherID, Index: 0}}, k.GetClaims(ctx))
+	require.Equal(t, uscrt(2000), k.GetModuleAccountBalance(ctx))
+	requireInvariants(t, ctx, k)
+
+	_, err = k.Reclaim(expiredCtx, airdropID, creator)
+	require.True(t, errors.Is(err, types.ErrUnknownAirdrop))
+}
+
+func TestInvariants(t *testing.T) {
+	ctx, _, k := CreateTestInput(t, initCoins)
+	root, _ := distribution(1000, 2000)
+
+	airdropID, err := k.CreateAirdrop(ctx, TestAddrs[0], root, uscrt(3000), 100)
+	require.NoError(t, err)
+	requireInvariants(t, ctx, k)
+
+	// the module account can't pay out more than its balance
+	airdrop, _ := k.GetAirdrop(ctx, airdropID)
+	airdrop.Remaining = uscrt(3001)
+	k.SetAirdrop(ctx, airdrop)
+	_, broken := ModuleAccountInvariant(k)(ctx)
+	require.True(t, broken)
+
+	// claim records can't outlive their airdrop
+	k.SetClaimed(ctx, airdropID+1, 0)
+	_, broken = ClaimRecordsInvariant(k)(ctx)
+	require.True(t, broken)
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/enigmampc/enigmachain/x/airdrop/internal/types"
+)
+
+// NewQuerier returns an airdrop Querier handler.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case types.QueryAirdrop:
+			return queryAirdrop(ctx, req, k)
+
+		case types.QueryClaimStatus:
+			return queryClaimStatus(ctx, req, k)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
+		}
+	}
+}
+
+func queryAirdrop(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryAirdropParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	airdrop, found := k.GetAirdrop(ctx, params.AirdropID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownAirdrop, "%d", params.AirdropID)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, airdrop)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryClaimStatus(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryClaimStatusParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if _, found := k.GetAirdrop(ctx, params.AirdropID); !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownAirdrop, "%d", params.AirdropID)
+	}
+
+	status := types.NewClaimStatus(params.AirdropID, params.Index, k.IsClaimed(ctx, params.AirdropID, params.Index))
+
+	res, err := codec.MarshalJSONIndent(k.cdc, status)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
@@ -0,0 +1,101 @@
+package keeper
+
+// nolint:deadcode,unused
+// DONTCOVER
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/enigmampc/enigmachain/x/airdrop/internal/types"
+)
+
+// TestAddrs are the accounts funded by CreateTestInput
+var TestAddrs = []sdk.AccAddress{
+	sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+	sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+	sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+	sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+}
+
+// MakeTestCodec creates a codec used only for testing
+func MakeTestCodec() *codec.Codec {
+	var cdc = codec.New()
+
+	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	return cdc
+}
+
+// CreateTestInput returns a context at height 1, an account keeper and an
+// airdrop keeper initialized with the default genesis state, where every test
+// account holds initCoins
+func CreateTestInput(t *testing.T, initCoins sdk.Coins) (sdk.Context, auth.AccountKeeper, Keeper) {
+	keyAirdrop := sdk.NewKVStoreKey(types.StoreKey)
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+
+	ms.MountStoreWithDB(keyAirdrop, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+
+	err := ms.LoadLatestVersion()
+	require.Nil(t, err)
+
+	airdropAcc := supply.NewEmptyModuleAccount(types.ModuleName)
+
+	blacklistedAddrs := make(map[string]bool)
+	blacklistedAddrs[airdropAcc.GetAddress().String()] = true
+
+	cdc := MakeTestCodec()
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "foochainid", Height: 1}, false, log.NewNopLogger())
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, pk.Subspace(bank.DefaultParamspace), blacklistedAddrs)
+	maccPerms := map[string][]string{
+		types.ModuleName: nil,
+	}
+	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, maccPerms)
+
+	keeper := NewKeeper(cdc, keyAirdrop, supplyKeeper)
+
+	totalSupply := sdk.NewCoins()
+	for _, addr := range TestAddrs {
+		_, err := bankKeeper.AddCoins(ctx, addr, initCoins)
+		require.Nil(t, err)
+		totalSupply = totalSupply.Add(initCoins...)
+	}
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(totalSupply))
+
+	supplyKeeper.SetModuleAccount(ctx, airdropAcc)
+	keeper.SetNextAirdropID(ctx, types.DefaultGenesisState().NextAirdropID)
+
+	return ctx, accountKeeper, keeper
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// Airdrop defines a merkle airdrop whose budget is escrowed by the module
+// account until it is claimed. Allocations can be claimed up to and including
+// the end height, after which the creator can reclaim the remaining budget.
+type Airdrop struct {
+	ID         uint64           `json:"id" yaml:"id"`
+	Creator    sdk.AccAddress   `json:"creator" yaml:"creator"`
+	MerkleRoot tmbytes.HexBytes `json:"merkle_root" yaml:"merkle_root"`
+	Budget     sdk.Coins        `json:"budget" yaml:"budget"`
+	Remaining  sdk.Coins        `json:"remaining" yaml:"remaining"`
+	EndHeight  int64            `json:"end_height" yaml:"end_height"`
+}
+
+// NewAirdrop creates a new Airdrop instance with its whole budget unclaimed
+func NewAirdrop(id uint64, creator sdk.AccAddress, merkleRoot []byte, budget sdk.Coins, endHeight int64) Airdrop {
+	return Airdrop{
+		ID:         id,
+		Creator:    creator,
+		MerkleRoot: merkleRoot,
+		Budget:     budget,
+		Remaining:  budget,
+		EndHeight:  endHeight,
+	}
+}
+
+// IsExpired returns whether the airdrop can no longer be claimed at the given
+// block height
+func (a Airdrop) IsExpired(height int64) bool {
+	return height > a.EndHeight
+}
+
+// String implements the Stringer interface
+func (a Airdrop) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Airdrop %d:
+  Creator:     %s
+  Merkle Root: %s
+  Budget:      %s
+  Remaining:   %s
+  End Height:  %d`, a.ID, a.Creator, a.MerkleRoot, a.Budget, a.Remaining, a.EndHeight))
+}
+
+// MarshalYAML returns the YAML representation of an airdrop, with its merkle
+// root hex encoded
+func (a Airdrop) MarshalYAML() (interface{}, error) {
+	return struct {
+		ID         uint64    `yaml:"id"`
+		Creator    string    `yaml:"creator"`
+		MerkleRoot string    `yaml:"merkle_root"`
+		Budget     sdk.Coins `yaml:"budget"`
+		Remaining  sdk.Coins `yaml:"remaining"`
+		EndHeight  int64     `yaml:"end_height"`
+	}{
+		ID:         a.ID,
+		Creator:    a.Creator.String(),
+		MerkleRoot: a.MerkleRoot.String(),
+		Budget:     a.Budget,
+		Remaining:  a.Remaining,
+		EndHeight:  a.EndHeight,
+	}, nil
+}
+
+// Validate performs a stateless validation of the airdrop fields
+func (a Airdrop) Validate() error {
+	if a.Creator.Empty() {
+		return fmt.Errorf("airdrop %d: creator address cannot be empty", a.ID)
+	}
+	if len(a.MerkleRoot) != MerkleHashSize {
+		return fmt.Errorf("airdrop %d: merkle root must be %d bytes long", a.ID, MerkleHashSize)
+	}
+	if !a.Budget.IsValid() || a.Budget.Empty() {
+		return fmt.Errorf("airdrop %d: invalid budget %s", a.ID, a.Budget)
+	}
+	if !a.Remaining.IsValid() {
+		return fmt.Errorf("airdrop %d: invalid remaining budget %s", a.ID, a.Remaining)
+	}
+	if !a.Budget.IsAllGTE(a.Remaining) {
+		return fmt.Errorf("airdrop %d: remaining budget %s exceeds budget %s", a.ID, a.Remaining, a.Budget)
+	}
+	if a.EndHeight <= 0 {
+		return fmt.Errorf("airdrop %d: end height must be positive", a.ID)
+	}
+	return nil
+}
+
+// ClaimStatus reports whether a single allocation of an airdrop was claimed
+type ClaimStatus struct {
+	AirdropID uint64 `json:"airdrop_id" yaml:"airdrop_id"`
+	Index     uint64 `json:"index" yaml:"index"`
+	Claimed   bool   `json:"claimed" yaml:"claimed"`
+}
+
+// NewClaimStatus creates a new ClaimStatus instance
+func NewClaimStatus(airdropID, index uint64, claimed bool) ClaimStatus {
+	return ClaimStatus{
+		AirdropID: airdropID,
+		Index:     index,
+		Claimed:   claimed,
+	}
+}
+
+// String implements the Stringer interface
+func (cs ClaimStatus) String() string {
+	return fmt.Sprintf("Airdrop %d, index %d claimed: %t", cs.AirdropID, cs.Index, cs.Claimed)
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers concrete types on codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgCreateAirdrop{}, "enigmachain/MsgCreateAirdrop", nil)
+	cdc.RegisterConcrete(MsgClaimAirdrop{}, "enigmachain/MsgClaimAirdrop", nil)
+	cdc.RegisterConcrete(MsgReclaimAirdrop{}, "enigmachain/MsgReclaimAirdrop", nil)
+}
+
+// ModuleCdc is the generic sealed codec to be used throughout the module
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
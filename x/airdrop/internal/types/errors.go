@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/airdrop module sentinel errors
+var (
+	ErrUnknownAirdrop     = sdkerrors.Register(ModuleName, 1, "unknown airdrop")
+	ErrInvalidMerkleRoot  = sdkerrors.Register(ModuleName, 2, "invalid merkle root")
+	ErrInvalidProof       = sdkerrors.Register(ModuleName, 3, "invalid merkle proof")
+	ErrAlreadyClaimed     = sdkerrors.Register(ModuleName, 4, "airdrop allocation already claimed")
+	ErrInsufficientBudget = sdkerrors.Register(ModuleName, 5, "claim exceeds remaining airdrop budget")
+	ErrInvalidEndHeight   = sdkerrors.Register(ModuleName, 6, "invalid airdrop end height")
+	ErrAirdropExpired     = sdkerrors.Register(ModuleName, 7, "airdrop expired")
+	ErrAirdropNotExpired  = sdkerrors.Register(ModuleName, 8, "airdrop not expired")
+)
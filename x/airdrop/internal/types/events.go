@@ -0,0 +1,13 @@
+package types
+
+// airdrop module event types
+const (
+	EventTypeCreateAirdrop  = "create_airdrop"
+	EventTypeClaimAirdrop   = "claim_airdrop"
+	EventTypeReclaimAirdrop = "reclaim_airdrop"
+
+	AttributeKeyAirdropID  = "airdrop_id"
+	AttributeKeyMerkleRoot = "merkle_root"
+	AttributeKeyIndex      = "index"
+	AttributeValueCategory = ModuleName
+)
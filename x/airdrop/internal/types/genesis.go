@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+)
+
+// ClaimRecord identifies an allocation that was already claimed
+type ClaimRecord struct {
+	AirdropID uint64 `json:"airdrop_id" yaml:"airdrop_id"`
+	Index     uint64 `json:"index" yaml:"index"`
+}
+
+// GenesisState - airdrop genesis state
+type GenesisState struct {
+	NextAirdropID uint64        `json:"next_airdrop_id" yaml:"next_airdrop_id"`
+	Airdrops      []Airdrop     `json:"airdrops" yaml:"airdrops"`
+	Claims        []ClaimRecord `json:"claims" yaml:"claims"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(nextAirdropID uint64, airdrops []Airdrop, claims []ClaimRecord) GenesisState {
+	return GenesisState{
+		NextAirdropID: nextAirdropID,
+		Airdrops:      airdrops,
+		Claims:        claims,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		NextAirdropID: 1,
+	}
+}
+
+// ValidateGenesis - validate airdrop genesis data
+func ValidateGenesis(data GenesisState) error {
+	if data.NextAirdropID == 0 {
+		return fmt.Errorf("next airdrop id must be positive")
+	}
+
+	ids := make(map[uint64]bool, len(data.Airdrops))
+	for _, airdrop := range data.Airdrops {
+		if err := airdrop.Validate(); err != nil {
+			return err
+		}
+		if airdrop.ID == 0 || airdrop.ID >= data.NextAirdropID {
+			return fmt.Errorf("airdrop id %d is out of range [1, %d)", airdrop.ID, data.NextAirdropID)
+		}
+		if ids[airdrop.ID] {
+			return fmt.Errorf("duplicate airdrop id %d", airdrop.ID)
+		}
+		ids[airdrop.ID] = true
+	}
+
+	for _, claim := range data.Claims {
+		if !ids[claim.AirdropID] {
+			return fmt.Errorf("claim record references unknown airdrop %d", claim.AirdropID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+const (
+	// ModuleName is the name of the airdrop module
+	ModuleName = "airdrop"
+
+	// StoreKey is the default store key for the airdrop module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the airdrop module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the airdrop module
+	QuerierRoute = ModuleName
+)
+
+// Keys for airdrop store
+// Items are stored with the following key: values
+//
+// - 0x00: nextAirdropID
+//
+// - 0x01<airdropID_Bytes>: Airdrop
+//
+// - 0x02<airdropID_Bytes><index_Bytes>: []byte{0x01}
+var (
+	NextAirdropIDKey  = []byte{0x00}
+	AirdropsKeyPrefix = []byte{0x01}
+	ClaimsKeyPrefix   = []byte{0x02}
+)
+
+// GetAirdropIDBytes returns the byte representation of the airdropID
+func GetAirdropIDBytes(airdropID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, airdropID)
+	return bz
+}
+
+// GetAirdropIDFromBytes returns airdropID in uint64 format from a byte array
+func GetAirdropIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// AirdropKey gets a specific airdrop from the store
+func AirdropKey(airdropID uint64) []byte {
+	return append(AirdropsKeyPrefix, GetAirdropIDBytes(airdropID)...)
+}
+
+// ClaimsKey gets the first part of the claims key based on the airdropID
+func ClaimsKey(airdropID uint64) []byte {
+	return append(ClaimsKeyPrefix, GetAirdropIDBytes(airdropID)...)
+}
+
+// ClaimKey key of a specific claim from the store
+func ClaimKey(airdropID, index uint64) []byte {
+	indexBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBz, index)
+	return append(ClaimsKey(airdropID), indexBz...)
+}
+
+// SplitClaimKey splits a claim key into its airdropID and leaf index
+func SplitClaimKey(key []byte) (airdropID, index uint64) {
+	airdropID = GetAirdropIDFromBytes(key[1:9])
+	index = binary.BigEndian.Uint64(key[9:17])
+	return
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MerkleHashSize is the size in bytes of every node of an airdrop merkle tree
+const MerkleHashSize = sha256.Size
+
+// Leaves and inner nodes are hashed with distinct prefixes, as in RFC 6962,
+// so that an inner node can never be passed off as a leaf
+const (
+	leafPrefix  byte = 0x00
+	innerPrefix byte = 0x01
+)
+
+// LeafHash returns the merkle leaf committing to a single airdrop allocation.
+// Leaves are computed as sha256(0x00 || index || recipient || amount), where
+// index is the big-endian uint64 position of the allocation in the
+// distribution list, recipient is the raw account address and amount is the
+// canonical coins string (e.g. "1000uscrt").
+func LeafHash(index uint64, recipient sdk.AccAddress, amount sdk.Coins) []byte {
+	indexBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBz, index)
+
+	h := sha256.New()
+	h.Write([]byte{leafPrefix})
+	h.Write(indexBz)
+	h.Write(recipient)
+	h.Write([]byte(amount.String()))
+	return h.Sum(nil)
+}
+
+// BuildMerkleTree builds the merkle tree over the given leaves and returns its
+// root along with the inclusion proof of every leaf, in the order of leaves.
+// A node without a sibling is promoted to the next level unchanged.
+func BuildMerkleTree(leaves [][]byte) (root []byte, proofs [][][]byte) {
+	if len(leaves) == 0 {
+		return nil, nil
+	}
+
+	proofs = make([][][]byte, len(leaves))
+	positions := make([]int, len(leaves))
+	for i := range positions {
+		positions[i] = i
+	}
+
+	level := leaves
+	for len(level) > 1 {
+		for i, pos := range positions {
+			if sibling := pos ^ 1; sibling < len(level) {
+				proofs[i] = append(proofs[i], level[sibling])
+			}
+			positions[i] = pos / 2
+		}
+
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for j := 0; j < len(level); j += 2 {
+			if j+1 < len(level) {
+				next = append(next, hashPair(level[j], level[j+1]))
+			} else {
+				next = append(next, level[j])
+			}
+		}
+		level = next
+	}
+
+	return level[0], proofs
+}
+
+// VerifyMerkleProof checks that leaf is included in the tree identified by
+// root. Sibling pairs are sorted before hashing, so proofs don't need to carry
+// the position of each sibling.
+func VerifyMerkleProof(root, leaf []byte, proof [][]byte) bool {
+	computed := leaf
+	for _, sibling := range proof {
+		if len(sibling) != MerkleHashSize {
+			return false
+		}
+		computed = hashPair(computed, sibling)
+	}
+
+	return bytes.Equal(computed, root)
+}
+
+func hashPair(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+
+	h := sha256.New()
+	h.Write([]byte{innerPrefix})
+	h.Write(a)
+	h.Write(b)
+	return h.Sum(nil)
+}
@@ -0,0 +1,127 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type allocation struct {
+	recipient sdk.AccAddress
+	amount    sdk.Coins
+}
+
+func testAllocations(n int) []allocation {
+	allocations := make([]allocation, n)
+	for i := range allocations {
+		allocations[i] = allocation{
+			recipient: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+			amount:    sdk.NewCoins(sdk.NewInt64Coin("uscrt", int64(1000*(i+1)))),
+		}
+	}
+	return allocations
+}
+
+func buildTestTree(allocations []allocation) (root []byte, proofs [][][]byte) {
+	leaves := make([][]byte, len(allocations))
+	for i, a := range allocations {
+		leaves[i] = LeafHash(uint64(i), a.recipient, a.amount)
+	}
+	return BuildMerkleTree(leaves)
+}
+
+func TestLeafHash(t *testing.T) {
+	a := testAllocations(2)
+
+	leaf := LeafHash(0, a[0].recipient, a[0].amount)
+	require.Len(t, leaf, MerkleHashSize)
+	require.Equal(t, leaf, LeafHash(0, a[0].recipient, a[0].amount))
+
+	// leaves are prefixed with 0x00
+	indexBz := make([]byte, 8)
+	preimage := append([]byte{0x00}, indexBz...)
+	preimage = append(preimage, a[0].recipient...)
+	preimage = append(preimage, []byte(a[0].amount.String())...)
+	expected := sha256.Sum256(preimage)
+	require.Equal(t, expected[:], leaf)
+
+	require.NotEqual(t, leaf, LeafHash(1, a[0].recipient, a[0].amount))
+	require.NotEqual(t, leaf, LeafHash(0, a[1].recipient, a[0].amount))
+	require.NotEqual(t, leaf, LeafHash(0, a[0].recipient, a[1].amount))
+}
+
+func TestVerifyMerkleProof(t *testing.T) {
+	allocations := testAllocations(5)
+	root, proofs := buildTestTree(allocations)
+	require.Len(t, root, MerkleHashSize)
+	require.Len(t, proofs, len(allocations))
+
+	// 5 leaves make a 3 level tree
+	require.Len(t, proofs[0], 3)
+
+	for i, a := range allocations {
+		leaf := LeafHash(uint64(i), a.recipient, a.amount)
+		require.True(t, VerifyMerkleProof(root, leaf, proofs[i]), "allocation %d", i)
+	}
+
+	a := allocations[1]
+	leaf := LeafHash(1, a.recipient, a.amount)
+	proof := proofs[1]
+
+	testCases := []struct {
+		name  string
+		leaf  []byte
+		proof [][]byte
+	}{
+		{"wrong sibling", leaf, [][]byte{proofs[2][0], proof[1], proof[2]}},
+		{"wrong amount", LeafHash(1, a.recipient, allocations[0].amount), proof},
+		{"wrong recipient", LeafHash(1, allocations[0].recipient, a.amount), proof},
+		{"wrong index", LeafHash(0, a.recipient, a.amount), proof},
+		{"truncated proof", leaf, proof[:2]},
+		{"invalid sibling size", leaf, [][]byte{proof[0][:16], proof[1], proof[2]}},
+		{"empty proof", leaf, nil},
+	}
+
+	for _, tc := range testCases {
+		require.False(t, VerifyMerkleProof(root, tc.leaf, tc.proof), tc.name)
+	}
+}
+
+func TestVerifyMerkleProofSingleLeaf(t *testing.T) {
+	allocations := testAllocations(1)
+	root, proofs := buildTestTree(allocations)
+
+	leaf := LeafHash(0, allocations[0].recipient, allocations[0].amount)
+	require.Equal(t, leaf, root)
+	require.Empty(t, proofs[0])
+	require.True(t, VerifyMerkleProof(root, leaf, proofs[0]))
+	require.False(t, VerifyMerkleProof(root, LeafHash(1, allocations[0].recipient, allocations[0].amount), proofs[0]))
+}
+
+func TestHashPair(t *testing.T) {
+	allocations := testAllocations(2)
+	a := LeafHash(0, allocations[0].recipient, allocations[0].amount)
+	b := LeafHash(1, allocations[1].recipient, allocations[1].amount)
+
+	// inner nodes are prefixed with 0x01 and hash their sorted children
+	first, second := a, b
+	if bytes.Compare(a, b) > 0 {
+		first, second = b, a
+	}
+	expected := sha256.Sum256(append(append([]byte{0x01}, first...), second...))
+
+	require.Equal(t, expected[:], hashPair(a, b))
+	require.Equal(t, expected[:], hashPair(b, a))
+}
+
+func TestBuildMerkleTreeEmpty(t *testing.T) {
+	root, proofs := BuildMerkleTree(nil)
+	require.Nil(t, root)
+	require.Nil(t, proofs)
+}
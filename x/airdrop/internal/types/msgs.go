@@ -0,0 +1,173 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// airdrop message types
+const (
+	TypeMsgCreateAirdrop  = "create_airdrop"
+	TypeMsgClaimAirdrop   = "claim_airdrop"
+	TypeMsgReclaimAirdrop = "reclaim_airdrop"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg = MsgCreateAirdrop{}
+	_ sdk.Msg = MsgClaimAirdrop{}
+	_ sdk.Msg = MsgReclaimAirdrop{}
+)
+
+// MsgCreateAirdrop escrows a budget of native coins that can be claimed by the
+// recipients committed to in the merkle root until the end height
+type MsgCreateAirdrop struct {
+	Creator    sdk.AccAddress   `json:"creator" yaml:"creator"`
+	MerkleRoot tmbytes.HexBytes `json:"merkle_root" yaml:"merkle_root"`
+	Budget     sdk.Coins        `json:"budget" yaml:"budget"`
+	EndHeight  int64            `json:"end_height" yaml:"end_height"`
+}
+
+// NewMsgCreateAirdrop creates a new MsgCreateAirdrop instance
+func NewMsgCreateAirdrop(creator sdk.AccAddress, merkleRoot []byte, budget sdk.Coins, endHeight int64) MsgCreateAirdrop {
+	return MsgCreateAirdrop{
+		Creator:    creator,
+		MerkleRoot: merkleRoot,
+		Budget:     budget,
+		EndHeight:  endHeight,
+	}
+}
+
+// nolint
+func (msg MsgCreateAirdrop) Route() string { return RouterKey }
+func (msg MsgCreateAirdrop) Type() string  { return TypeMsgCreateAirdrop }
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgCreateAirdrop) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Creator} }
+
+// GetSignBytes gets the sign bytes for the msg MsgCreateAirdrop
+func (msg MsgCreateAirdrop) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgCreateAirdrop) ValidateBasic() error {
+	if msg.Creator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing creator address")
+	}
+	if len(msg.MerkleRoot) != MerkleHashSize {
+		return sdkerrors.Wrapf(ErrInvalidMerkleRoot, "expected %d bytes, got %d", MerkleHashSize, len(msg.MerkleRoot))
+	}
+	if !msg.Budget.IsValid() || msg.Budget.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Budget.String())
+	}
+	if msg.EndHeight <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidEndHeight, "%d", msg.EndHeight)
+	}
+	return nil
+}
+
+// MsgClaimAirdrop claims the allocation at the given index of an airdrop by
+// proving its inclusion in the airdrop's merkle root
+type MsgClaimAirdrop struct {
+	Claimer   sdk.AccAddress     `json:"claimer" yaml:"claimer"`
+	AirdropID uint64             `json:"airdrop_id" yaml:"airdrop_id"`
+	Index     uint64             `json:"index" yaml:"index"`
+	Amount    sdk.Coins          `json:"amount" yaml:"amount"`
+	Proof     []tmbytes.HexBytes `json:"proof" yaml:"proof"`
+}
+
+// NewMsgClaimAirdrop creates a new MsgClaimAirdrop instance
+func NewMsgClaimAirdrop(
+	claimer sdk.AccAddress, airdropID, index uint64, amount sdk.Coins, proof []tmbytes.HexBytes,
+) MsgClaimAirdrop {
+
+	return MsgClaimAirdrop{
+		Claimer:   claimer,
+		AirdropID: airdropID,
+		Index:     index,
+		Amount:    amount,
+		Proof:     proof,
+	}
+}
+
+// nolint
+func (msg MsgClaimAirdrop) Route() string { return RouterKey }
+func (msg MsgClaimAirdrop) Type() string  { return TypeMsgClaimAirdrop }
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgClaimAirdrop) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Claimer} }
+
+// GetSignBytes gets the sign bytes for the msg MsgClaimAirdrop
+func (msg MsgClaimAirdrop) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgClaimAirdrop) ValidateBasic() error {
+	if msg.Claimer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing claimer address")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	for _, node := range msg.Proof {
+		if len(node) != MerkleHashSize {
+			return sdkerrors.Wrapf(ErrInvalidProof, "proof nodes must be %d bytes long", MerkleHashSize)
+		}
+	}
+	return nil
+}
+
+// LeafHash returns the merkle leaf this claim proves inclusion of
+func (msg MsgClaimAirdrop) LeafHash() []byte {
+	return LeafHash(msg.Index, msg.Claimer, msg.Amount)
+}
+
+// ProofBytes returns the merkle proof as raw bytes
+func (msg MsgClaimAirdrop) ProofBytes() [][]byte {
+	proof := make([][]byte, len(msg.Proof))
+	for i, node := range msg.Proof {
+		proof[i] = node
+	}
+	return proof
+}
+
+// MsgReclaimAirdrop returns the remaining budget of an expired airdrop to its
+// creator and deletes the airdrop along with its claim records
+type MsgReclaimAirdrop struct {
+	Creator   sdk.AccAddress `json:"creator" yaml:"creator"`
+	AirdropID uint64         `json:"airdrop_id" yaml:"airdrop_id"`
+}
+
+// NewMsgReclaimAirdrop creates a new MsgReclaimAirdrop instance
+func NewMsgReclaimAirdrop(creator sdk.AccAddress, airdropID uint64) MsgReclaimAirdrop {
+	return MsgReclaimAirdrop{
+		Creator:   creator,
+		AirdropID: airdropID,
+	}
+}
+
+// nolint
+func (msg MsgReclaimAirdrop) Route() string { return RouterKey }
+func (msg MsgReclaimAirdrop) Type() string  { return TypeMsgReclaimAirdrop }
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgReclaimAirdrop) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Creator} }
+
+// GetSignBytes gets the sign bytes for the msg MsgReclaimAirdrop
+func (msg MsgReclaimAirdrop) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgReclaimAirdrop) ValidateBasic() error {
+	if msg.Creator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing creator address")
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package types
+
+// querier keys
+const (
+	QueryAirdrop     = "airdrop"
+	QueryClaimStatus = "claim_status"
+)
+
+// QueryAirdropParams defines the params for the following queries:
+// - 'custom/airdrop/airdrop'
+type QueryAirdropParams struct {
+	AirdropID uint64 `json:"airdrop_id" yaml:"airdrop_id"`
+}
+
+// NewQueryAirdropParams creates a new instance of QueryAirdropParams
+func NewQueryAirdropParams(airdropID uint64) QueryAirdropParams {
+	return QueryAirdropParams{
+		AirdropID: airdropID,
+	}
+}
+
+// QueryClaimStatusParams defines the params for the following queries:
+// - 'custom/airdrop/claim_status'
+type QueryClaimStatusParams struct {
+	AirdropID uint64 `json:"airdrop_id" yaml:"airdrop_id"`
+	Index     uint64 `json:"index" yaml:"index"`
+}
+
+// NewQueryClaimStatusParams creates a new instance of QueryClaimStatusParams
+func NewQueryClaimStatusParams(airdropID, index uint64) QueryClaimStatusParams {
+	return QueryClaimStatusParams{
+		AirdropID: airdropID,
+		Index:     index,
+	}
+}